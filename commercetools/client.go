@@ -0,0 +1,374 @@
+package commercetools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/labd/commercetools-go-sdk/commercetools"
+
+// Client is the low level HTTP client used to talk to a commercetools
+// project. It is safe for concurrent use.
+type Client struct {
+	// Context is used as the parent context for every request issued by
+	// the Client. It defaults to context.Background() and is typically
+	// overridden per call site via Client.WithContext.
+	Context context.Context
+	Config  *Config
+
+	httpClient *http.Client
+
+	tracer trace.Tracer
+	meter  metric.Meter
+
+	requestDuration metric.Float64Histogram
+	retryCounter    metric.Int64Counter
+	errorCounter    metric.Int64Counter
+
+	retryPolicy *RetryPolicy
+
+	// scheduler is the background token-refresh goroutine started by New
+	// when Config.TokenSource is set. ownsScheduler is true only on the
+	// Client New returned, not on WithContext clones, which share the same
+	// *tokenScheduler; this keeps a context-scoped clone from being able to
+	// shut down token refresh for every Client derived from the same root.
+	scheduler     *tokenScheduler
+	ownsScheduler bool
+}
+
+// New constructs a Client for the given Config. The tracer and meter used
+// to instrument requests are taken from Config.TracerProvider/MeterProvider,
+// falling back to the global OpenTelemetry providers when unset.
+func New(cfg *Config) *Client {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	tracerProvider := cfg.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	meterProvider := cfg.MeterProvider
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+
+	meter := meterProvider.Meter(instrumentationName)
+
+	requestDuration, _ := meter.Float64Histogram(
+		"commercetools.client.request.duration",
+		metric.WithDescription("Duration of commercetools API requests, in milliseconds"),
+		metric.WithUnit("ms"),
+	)
+	retryCounter, _ := meter.Int64Counter(
+		"commercetools.client.retry.count",
+		metric.WithDescription("Number of commercetools API requests that were retried"),
+	)
+	errorCounter, _ := meter.Int64Counter(
+		"commercetools.client.error.count",
+		metric.WithDescription("Number of commercetools API requests that returned an error, broken down by error class"),
+	)
+
+	retryPolicy := cfg.RetryPolicy
+	if retryPolicy == nil {
+		retryPolicy = DefaultRetryPolicy()
+	}
+
+	client := &Client{
+		Context:         context.Background(),
+		Config:          cfg,
+		httpClient:      httpClient,
+		tracer:          tracerProvider.Tracer(instrumentationName),
+		meter:           meter,
+		requestDuration: requestDuration,
+		retryCounter:    retryCounter,
+		errorCounter:    errorCounter,
+		retryPolicy:     retryPolicy,
+	}
+
+	if cfg.TokenSource != nil {
+		client.scheduler = newTokenScheduler(cfg.TokenSource)
+		client.ownsScheduler = true
+		go client.scheduler.run(client.Context)
+	}
+
+	return client
+}
+
+// WithContext returns a shallow copy of the Client that issues requests
+// with ctx as their parent, so callers can propagate an incoming span into
+// the requests this Client makes. The clone shares the original Client's
+// token-refresh scheduler, if any, but Close on the clone is a no-op;
+// only the original Client returned by New can stop it, so a
+// context-scoped clone can't shut down token refresh out from under every
+// other Client derived from the same root.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	clone := *c
+	clone.Context = ctx
+	clone.ownsScheduler = false
+	return &clone
+}
+
+// Close stops the background token-refresh scheduler, if one was started
+// because Config.TokenSource was set. It is a no-op otherwise, including on
+// a Client obtained via WithContext, which does not own the scheduler. It
+// is safe to call more than once.
+func (c *Client) Close() {
+	if c.scheduler != nil && c.ownsScheduler {
+		c.scheduler.close()
+	}
+}
+
+// Get issues a GET request against path, encoding params as the query
+// string, and decodes the JSON response body into output. operation names
+// the span for this call, e.g. "TaxCategoryGet"; like Query's resource
+// argument, it should be a fixed, resource-shaped name rather than
+// anything derived from path, so calls against different IDs don't each
+// mint a distinct span name. The metrics are not labelled by operation.
+func (c *Client) Get(operation, path string, params url.Values, output interface{}) error {
+	return c.do(http.MethodGet, operation, path, params, nil, output)
+}
+
+// Post issues a POST request, JSON-encoding body as the request payload and
+// decoding the JSON response body into output. operation names the span
+// for this call; see Get.
+func (c *Client) Post(operation, path string, params url.Values, body interface{}, output interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return c.do(http.MethodPost, operation, path, params, data, output)
+}
+
+// Delete issues a DELETE request against path and decodes the JSON
+// response body into output. operation names the span for this call; see
+// Get.
+func (c *Client) Delete(operation, path string, params url.Values, output interface{}) error {
+	return c.do(http.MethodDelete, operation, path, params, nil, output)
+}
+
+// Query issues a GET request against a resource's query endpoint,
+// translating input into the commercetools query string parameters. The
+// span is named `<resource>Query`.
+func (c *Client) Query(resource string, path string, input *QueryInput, output interface{}) error {
+	return c.do(http.MethodGet, resource+"Query", path, input.Values(), nil, output)
+}
+
+// do sends method/path, retrying according to c.retryPolicy when the
+// response is a rate-limit (429/503) or ConcurrentModification error. Each
+// failed attempt feeds the error metric, and each retry adds a span event
+// and feeds the retry metric, so
+// operators can see tail-latency caused by refresh storms or contention
+// hot spots.
+func (c *Client) do(method, spanName, path string, params url.Values, bodyBytes []byte, output interface{}) error {
+	ctx, span := c.tracer.Start(c.Context, spanName, trace.WithAttributes(
+		attribute.String("http.method", method),
+		attribute.String("commercetools.projectKey", c.Config.ProjectKey),
+	))
+	defer span.End()
+
+	for attempt := 0; ; attempt++ {
+		err := c.attempt(ctx, span, method, path, params, bodyBytes, output)
+		if err == nil {
+			return nil
+		}
+
+		c.errorCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("commercetools.errorClass", errorClass(err))))
+
+		delay, retryable := c.retryPolicy.nextDelay(attempt, err)
+		if !retryable {
+			return err
+		}
+
+		c.retryCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("commercetools.errorClass", errorClass(err))))
+		span.AddEvent("retry", trace.WithAttributes(
+			attribute.Int("attempt", attempt+1),
+			attribute.Int64("delay_ms", delay.Milliseconds()),
+		))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) attempt(ctx context.Context, span trace.Span, method, path string, params url.Values, bodyBytes []byte, output interface{}) error {
+	var body io.Reader
+	if bodyBytes != nil {
+		body = bytes.NewReader(bodyBytes)
+	}
+
+	resp, err := c.sendRequest(ctx, span, method, path, params, body, "")
+	if err != nil {
+		return err
+	}
+
+	if err := c.handleResponse(resp, output); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// sendRequest builds and issues a single HTTP request, recording the span
+// attributes and request-duration metric shared by every call path
+// (buffered JSON calls and the streaming calls in stream.go alike), and
+// propagating the span via W3C traceparent headers so calls made from e.g.
+// a Terraform provider or webhook handler show up as children of the
+// caller's span in commercetools' own traces.
+//
+// contentTypeOverride, if non-empty, replaces the "application/json"
+// Content-Type newRequest sets for a non-nil body; streaming uploads pass
+// their actual content type here.
+func (c *Client) sendRequest(ctx context.Context, span trace.Span, method, path string, params url.Values, body io.Reader, contentTypeOverride string) (*http.Response, error) {
+	start := time.Now()
+
+	req, err := c.newRequest(ctx, method, path, params, body)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	if contentTypeOverride != "" {
+		req.Header.Set("Content-Type", contentTypeOverride)
+	}
+	span.SetAttributes(attribute.String("http.url", req.URL.String()))
+
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := c.httpClient.Do(req)
+	c.requestDuration.Record(ctx, float64(time.Since(start).Milliseconds()),
+		metric.WithAttributes(attribute.String("http.method", method)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("commercetools.correlationId", req.Header.Get("X-Correlation-ID")),
+		attribute.String("commercetools.returnedCorrelationId", resp.Header.Get("X-Correlation-ID")),
+	)
+
+	return resp, nil
+}
+
+func (c *Client) newRequest(ctx context.Context, method, path string, params url.Values, body io.Reader) (*http.Request, error) {
+	u := c.Config.URL + path
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", GetUserAgent(c.Config))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.Config.TokenSource != nil {
+		token, err := c.Config.TokenSource.Token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	}
+
+	return req, nil
+}
+
+func (c *Client) handleResponse(resp *http.Response, output interface{}) error {
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if output == nil || len(data) == 0 {
+			return nil
+		}
+		return json.Unmarshal(data, output)
+	}
+
+	return c.handleErrorResponse(resp, data)
+}
+
+func (c *Client) handleErrorResponse(resp *http.Response, data []byte) error {
+	var errResp ErrorResponse
+	if len(data) == 0 {
+		errResp = newErrorResponse(resp.StatusCode)
+	} else if err := json.Unmarshal(data, &errResp); err != nil {
+		return err
+	}
+
+	errResp.CorrelationID = resp.Header.Get("X-Correlation-ID")
+	if serverTime, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		errResp.ServerTime = serverTime
+	}
+	if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		errResp.RetryAfter = &retryAfter
+	}
+
+	return errResp
+}
+
+// parseRetryAfter parses a Retry-After header value, which commercetools
+// sends as a number of seconds on 429 (rate limited) and 503 (overloaded)
+// responses.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// errorClass reports the concrete error type backing err, e.g.
+// "InsufficientScopeError", so it can be used as a low-cardinality metric
+// attribute without leaking request-specific detail.
+func errorClass(err error) string {
+	ctErr, ok := err.(ErrorResponse)
+	if !ok || len(ctErr.Errors) == 0 {
+		return "unknown"
+	}
+
+	switch ctErr.Errors[0].(type) {
+	case InsufficientScopeError:
+		return "InsufficientScopeError"
+	case InvalidJSONInputError:
+		return "InvalidJSONInputError"
+	case ConcurrentModificationError:
+		return "ConcurrentModificationError"
+	default:
+		return "GenericError"
+	}
+}
+
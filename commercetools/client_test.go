@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/labd/commercetools-go-sdk/commercetools"
 	"github.com/labd/commercetools-go-sdk/testutil"
@@ -29,7 +30,7 @@ func TestClientGetBadRequestJson(t *testing.T) {
 
 	output := OutputData{}
 
-	err := client.Get("/", nil, &output)
+	err := client.Get("Test", "/", nil, &output)
 	assert.Equal(t, "invalid character ',' looking for beginning of value", err.Error())
 }
 
@@ -41,7 +42,7 @@ func TestClientNotFound(t *testing.T) {
 
 	output := OutputData{}
 
-	err := client.Get("/", nil, &output)
+	err := client.Get("Test", "/", nil, &output)
 	assert.Equal(t, "Not Found (404): ResourceNotFound", err.Error())
 
 	ctErr, ok := err.(commercetools.ErrorResponse)
@@ -69,7 +70,7 @@ func TestAuthError(t *testing.T) {
 
 	output := OutputData{}
 
-	err := client.Get("/", nil, &output)
+	err := client.Get("Test", "/", nil, &output)
 
 	assert.Equal(t, "Insufficient scope", err.Error())
 
@@ -100,7 +101,7 @@ func TestInvalidJsonError(t *testing.T) {
 
 	output := OutputData{}
 
-	err := client.Get("/", nil, &output)
+	err := client.Get("Test", "/", nil, &output)
 
 	assert.Equal(t, "Request body does not contain valid JSON.", err.Error())
 
@@ -263,3 +264,60 @@ func TestUserAgents(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorResponseHasErrorCode(t *testing.T) {
+	body := `{
+		"statusCode": 409,
+		"message": "Object ... has a different version than expected.",
+		"errors": [
+			{
+				"code": "ConcurrentModification",
+				"message": "Object ... has a different version than expected.",
+				"currentVersion": 4
+			}
+		]
+	}`
+	client, server := testutil.MockClientWithConfig(
+		t, "", nil, errorHandler(http.StatusConflict, body, "application/json"),
+		disableRetries)
+	defer server.Close()
+
+	output := OutputData{}
+
+	err := client.Get("Test", "/", nil, &output)
+
+	ctErr, ok := err.(commercetools.ErrorResponse)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, true, ctErr.HasErrorCode("ConcurrentModification"))
+	assert.Equal(t, false, ctErr.HasErrorCode("ResourceNotFound"))
+
+	concurrentErr, ok := commercetools.FirstErrorOfType[commercetools.ConcurrentModificationError](ctErr)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 4, concurrentErr.CurrentVersion)
+}
+
+func TestErrorResponseRetryAfter(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "2")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"statusCode": 429, "message": "Rate limit exceeded", "errors": []}`))
+	}
+	client, server := testutil.MockClientWithConfig(t, "", nil, handler, disableRetries)
+	defer server.Close()
+
+	output := OutputData{}
+	err := client.Get("Test", "/", nil, &output)
+
+	ctErr, ok := err.(commercetools.ErrorResponse)
+	assert.Equal(t, true, ok)
+	if assert.NotNil(t, ctErr.RetryAfter) {
+		assert.Equal(t, 2*time.Second, *ctErr.RetryAfter)
+	}
+}
+
+// disableRetries is used by tests that assert on the first error response
+// rather than retry behavior, so they don't sleep through real backoff
+// delays.
+func disableRetries(cfg *commercetools.Config) {
+	cfg.RetryPolicy = &commercetools.RetryPolicy{}
+}
@@ -0,0 +1,87 @@
+package commercetools
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// sdkVersion is the released version of this SDK, used to build the
+// User-Agent header sent with every request.
+const sdkVersion = "1.0.0"
+
+// Config holds the settings used to construct a Client.
+type Config struct {
+	// URL is the base URL of the commercetools API (or a compatible
+	// gateway) the Client talks to.
+	URL string
+
+	// ProjectKey is the commercetools project the Client operates on.
+	ProjectKey string
+
+	// HTTPClient is used to perform the actual HTTP calls. It defaults to
+	// http.DefaultClient when nil.
+	HTTPClient *http.Client
+
+	// LibraryName and LibraryVersion identify the application embedding
+	// this SDK (e.g. "terraform-provider-commercetools") and are appended
+	// to the User-Agent header.
+	LibraryName    string
+	LibraryVersion string
+
+	// ContactURL and ContactEmail are included in the User-Agent header so
+	// commercetools can reach out about the calling application.
+	ContactURL   string
+	ContactEmail string
+
+	// TracerProvider and MeterProvider are used to create the tracer and
+	// meter the Client instruments its requests with. They default to the
+	// global providers (otel.GetTracerProvider/otel.GetMeterProvider) when
+	// nil, so embedding applications only need to set these when they want
+	// a project-specific provider rather than the process-wide default.
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+
+	// TokenSource, when set, is used to proactively refresh the Client's
+	// OAuth token ahead of expiry via a background scheduler, instead of
+	// only reacting to a 401.
+	TokenSource TokenSource
+
+	// RetryPolicy controls how failed requests are retried. It defaults to
+	// DefaultRetryPolicy(); set it to &RetryPolicy{} to disable retries.
+	RetryPolicy *RetryPolicy
+}
+
+// GetUserAgent builds the User-Agent header sent with every request for the
+// given Config.
+func GetUserAgent(cfg *Config) string {
+	parts := []string{
+		fmt.Sprintf("commercetools-go-sdk/%s", sdkVersion),
+		fmt.Sprintf("Go/%s (%s; %s)", runtime.Version(), runtime.GOOS, runtime.GOARCH),
+	}
+
+	if cfg.LibraryName != "" {
+		if cfg.LibraryVersion != "" {
+			parts = append(parts, fmt.Sprintf("%s/%s", cfg.LibraryName, cfg.LibraryVersion))
+		} else {
+			parts = append(parts, cfg.LibraryName)
+		}
+	}
+
+	var contact []string
+	if cfg.ContactURL != "" {
+		contact = append(contact, fmt.Sprintf("+%s", cfg.ContactURL))
+	}
+	if cfg.ContactEmail != "" {
+		contact = append(contact, fmt.Sprintf("+%s", cfg.ContactEmail))
+	}
+	if len(contact) > 0 {
+		parts = append(parts, fmt.Sprintf("(%s)", strings.Join(contact, "; ")))
+	}
+
+	return strings.Join(parts, " ")
+}
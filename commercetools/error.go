@@ -0,0 +1,199 @@
+package commercetools
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Error is implemented by every concrete commercetools API error, such as
+// InsufficientScopeError or InvalidJSONInputError.
+type Error interface {
+	error
+	Code() string
+}
+
+// GenericError is used for error codes this SDK does not have a concrete
+// type for yet.
+type GenericError struct {
+	ErrorCode string `json:"code"`
+	Message   string `json:"message"`
+}
+
+func (e GenericError) Error() string { return e.Message }
+func (e GenericError) Code() string  { return e.ErrorCode }
+
+// InsufficientScopeError is returned when the OAuth token used for the
+// request does not carry the scope required by the endpoint.
+type InsufficientScopeError struct {
+	Message string `json:"message"`
+}
+
+func (e InsufficientScopeError) Error() string { return e.Message }
+func (e InsufficientScopeError) Code() string  { return "insufficient_scope" }
+
+// InvalidJSONInputError is returned when the request body could not be
+// parsed as JSON.
+type InvalidJSONInputError struct {
+	Message              string `json:"message"`
+	DetailedErrorMessage string `json:"detailedErrorMessage"`
+}
+
+func (e InvalidJSONInputError) Error() string { return e.Message }
+func (e InvalidJSONInputError) Code() string  { return "InvalidJsonInput" }
+
+// ConcurrentModificationError is returned when an update is sent against a
+// stale Version, the standard pattern commercetools uses for optimistic
+// concurrency on resources such as Cart and Order.
+type ConcurrentModificationError struct {
+	Message        string `json:"message"`
+	CurrentVersion int    `json:"currentVersion"`
+}
+
+func (e ConcurrentModificationError) Error() string { return e.Message }
+func (e ConcurrentModificationError) Code() string  { return "ConcurrentModification" }
+
+// ErrorResponse is returned by the Client whenever commercetools responds
+// with a non-2xx status code.
+type ErrorResponse struct {
+	StatusCode int     `json:"statusCode"`
+	Message    string  `json:"message"`
+	Errors     []Error `json:"errors"`
+
+	// CorrelationID is copied from the X-Correlation-ID response header so
+	// callers can reference it in support requests.
+	CorrelationID string `json:"-"`
+
+	// ServerTime is copied from the response's Date header.
+	ServerTime time.Time `json:"-"`
+
+	// RetryAfter is parsed from the Retry-After header commercetools sends
+	// on 429 (rate limited) and 503 (overloaded) responses. It is nil when
+	// the response didn't carry one.
+	RetryAfter *time.Duration `json:"-"`
+}
+
+func (e ErrorResponse) Error() string { return e.Message }
+
+// HasErrorCode reports whether any of e.Errors has the given code, so
+// callers can write `if err.HasErrorCode("ConcurrentModification")` instead
+// of type-switching over e.Errors[0].
+func (e ErrorResponse) HasErrorCode(code string) bool {
+	for _, err := range e.Errors {
+		if err.Code() == code {
+			return true
+		}
+	}
+	return false
+}
+
+// FirstErrorOfType returns the first error in r.Errors assignable to T,
+// and true if one was found. Go methods can't be generic, so this is a
+// function rather than a method on ErrorResponse:
+//
+//	if concurrent, ok := commercetools.FirstErrorOfType[commercetools.ConcurrentModificationError](err); ok {
+//		...
+//	}
+func FirstErrorOfType[T Error](r ErrorResponse) (T, bool) {
+	for _, err := range r.Errors {
+		if typed, ok := err.(T); ok {
+			return typed, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// MarshalJSON emits a `status` field alongside the existing data so log
+// pipelines can classify the payload as an error without inspecting its
+// Go type.
+func (e ErrorResponse) MarshalJSON() ([]byte, error) {
+	type alias ErrorResponse
+	return json.Marshal(struct {
+		Status string `json:"status"`
+		alias
+	}{
+		Status: "error",
+		alias:  alias(e),
+	})
+}
+
+// UnmarshalJSON decodes the polymorphic `errors` array into the concrete
+// Error type matching each entry's `code`, falling back to GenericError for
+// codes this SDK doesn't model explicitly.
+func (e *ErrorResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		StatusCode int               `json:"statusCode"`
+		Message    string            `json:"message"`
+		Errors     []json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	e.StatusCode = raw.StatusCode
+	e.Message = raw.Message
+	e.Errors = make([]Error, 0, len(raw.Errors))
+
+	for _, item := range raw.Errors {
+		var head struct {
+			Code string `json:"code"`
+		}
+		if err := json.Unmarshal(item, &head); err != nil {
+			return err
+		}
+
+		switch head.Code {
+		case "insufficient_scope":
+			var err InsufficientScopeError
+			if err2 := json.Unmarshal(item, &err); err2 != nil {
+				return err2
+			}
+			e.Errors = append(e.Errors, err)
+		case "InvalidJsonInput":
+			var err InvalidJSONInputError
+			if err2 := json.Unmarshal(item, &err); err2 != nil {
+				return err2
+			}
+			e.Errors = append(e.Errors, err)
+		case "ConcurrentModification":
+			var err ConcurrentModificationError
+			if err2 := json.Unmarshal(item, &err); err2 != nil {
+				return err2
+			}
+			e.Errors = append(e.Errors, err)
+		default:
+			var err GenericError
+			if err2 := json.Unmarshal(item, &err); err2 != nil {
+				return err2
+			}
+			e.Errors = append(e.Errors, err)
+		}
+	}
+
+	return nil
+}
+
+// statusCodeToErrorCode is used to synthesize an ErrorResponse for non-2xx
+// responses that don't carry a JSON body (e.g. a bare 404 from a
+// misconfigured gateway).
+func statusCodeToErrorCode(statusCode int) string {
+	switch statusCode {
+	case http.StatusNotFound:
+		return "ResourceNotFound"
+	case http.StatusForbidden:
+		return "insufficient_scope"
+	case http.StatusConflict:
+		return "ConcurrentModification"
+	default:
+		return "General"
+	}
+}
+
+func newErrorResponse(statusCode int) ErrorResponse {
+	return ErrorResponse{
+		StatusCode: statusCode,
+		Message:    fmt.Sprintf("%s (%d): %s", http.StatusText(statusCode), statusCode, statusCodeToErrorCode(statusCode)),
+	}
+}
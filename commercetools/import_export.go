@@ -0,0 +1,20 @@
+package commercetools
+
+import (
+	"io"
+	"net/url"
+)
+
+// ImportStream streams a large import payload (e.g. a product import feed)
+// directly to a Type/Import endpoint without buffering the entire file in
+// memory. The JSON response body, if any, is decoded into output.
+func (c *Client) ImportStream(path string, contentType string, body io.Reader, output interface{}) error {
+	return c.PostStream("ImportStream", path, nil, contentType, body, output)
+}
+
+// ExportStream reads a large export result (e.g. a product or order
+// export) from an Export endpoint without buffering it in memory. The
+// caller owns the returned ReadCloser and must close it.
+func (c *Client) ExportStream(path string, params url.Values) (io.ReadCloser, error) {
+	return c.GetStream("ExportStream", path, params)
+}
@@ -0,0 +1,111 @@
+package commercetools_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/labd/commercetools-go-sdk/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstrumentationRecordsSpanAndPropagatesTraceContext(t *testing.T) {
+	// The Client injects via the global propagator (otel.GetTextMapPropagator),
+	// matching how applications configure OpenTelemetry; install a W3C
+	// TraceContext propagator for the duration of this test.
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(previous)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	var traceparent string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Header().Set("X-Correlation-ID", "test-correlation-id")
+		w.Write([]byte(`{}`))
+	}
+
+	client, server := testutil.MockClientWithConfig(t, "", nil, handler, func(cfg *commercetools.Config) {
+		cfg.TracerProvider = tracerProvider
+	})
+	defer server.Close()
+
+	// Issue the request with a parent span so we can assert the span
+	// created by the Client is its child.
+	parentTracer := tracerProvider.Tracer("test")
+	ctx, parentSpan := parentTracer.Start(context.Background(), "parent")
+	err := client.WithContext(ctx).Query("TaxCategory", "/tax-categories", nil, &struct{}{})
+	parentSpan.End()
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, traceparent, "expected the request to carry a W3C traceparent header")
+
+	spans := recorder.Ended()
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+
+	requestSpan := spans[0]
+	assert.Equal(t, "TaxCategoryQuery", requestSpan.Name())
+	assert.Equal(t, parentSpan.SpanContext().TraceID(), requestSpan.SpanContext().TraceID())
+	assert.Equal(t, parentSpan.SpanContext().SpanID(), requestSpan.Parent().SpanID())
+
+	var sawMethod, sawProjectKey bool
+	for _, attr := range requestSpan.Attributes() {
+		switch string(attr.Key) {
+		case "http.method":
+			sawMethod = attr.Value.AsString() == "GET"
+		case "commercetools.projectKey":
+			sawProjectKey = attr.Value.AsString() == "test-project"
+		}
+	}
+	assert.True(t, sawMethod)
+	assert.True(t, sawProjectKey)
+
+	// The propagator should have injected the very traceparent captured by
+	// the mock server, proving it was the request's span (not some other
+	// context) that got propagated.
+	carrier := propagation.MapCarrier{"traceparent": traceparent}
+	propagatedCtx := propagation.TraceContext{}.Extract(context.Background(), carrier)
+	propagatedSpanContext := trace.SpanContextFromContext(propagatedCtx)
+	assert.Equal(t, requestSpan.SpanContext().TraceID(), propagatedSpanContext.TraceID())
+	assert.Equal(t, requestSpan.SpanContext().SpanID(), propagatedSpanContext.SpanID())
+}
+
+func TestInstrumentationRecordsRequestDurationMetric(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	client, server := testutil.MockClientWithConfig(t, "{}", nil, nil, func(cfg *commercetools.Config) {
+		cfg.MeterProvider = meterProvider
+	})
+	defer server.Close()
+
+	output := OutputData{}
+	err := client.Get("Test", "/", nil, &output)
+	assert.Nil(t, err)
+
+	var data metricdata.ResourceMetrics
+	assert.Nil(t, reader.Collect(context.Background(), &data))
+
+	var found bool
+	for _, sm := range data.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == "commercetools.client.request.duration" {
+				found = true
+			}
+		}
+	}
+	assert.True(t, found, "expected a commercetools.client.request.duration histogram to be recorded")
+}
@@ -0,0 +1,108 @@
+// Package predicates provides a typed DSL for building commercetools query
+// predicate expressions, so callers don't need to hand-assemble strings for
+// QueryInput.Where. Per-resource field helpers (e.g. package
+// predicates/products) are generated by internal/cmd/genpredicates from
+// `predicate:"..."` tags on the corresponding resource struct, so they stay
+// in sync with the struct that already defines the resource's shape.
+package predicates
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Predicate is a commercetools query predicate expression. It implements
+// fmt.Stringer; its String() result is what goes into QueryInput.Where.
+type Predicate string
+
+func (p Predicate) String() string { return string(p) }
+
+// And combines predicates with the commercetools `and` operator.
+func And(predicates ...Predicate) Predicate {
+	return combine("and", predicates)
+}
+
+// Or combines predicates with the commercetools `or` operator.
+func Or(predicates ...Predicate) Predicate {
+	return combine("or", predicates)
+}
+
+// Not negates a predicate.
+func Not(p Predicate) Predicate {
+	return Predicate(fmt.Sprintf("not (%s)", p))
+}
+
+func combine(op string, predicates []Predicate) Predicate {
+	if len(predicates) == 1 {
+		return predicates[0]
+	}
+
+	parts := make([]string, len(predicates))
+	for i, p := range predicates {
+		parts[i] = string(p)
+	}
+	return Predicate(strings.Join(parts, " "+op+" "))
+}
+
+// StringField is a string-typed resource field, e.g. a product's slug for
+// a given locale.
+type StringField string
+
+func (f StringField) Eq(value string) Predicate {
+	return Predicate(fmt.Sprintf("%s = %s", f, quote(value)))
+}
+
+func (f StringField) NotEq(value string) Predicate {
+	return Predicate(fmt.Sprintf("%s != %s", f, quote(value)))
+}
+
+func (f StringField) In(values ...string) Predicate {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = quote(v)
+	}
+	return Predicate(fmt.Sprintf("%s in (%s)", f, strings.Join(quoted, ", ")))
+}
+
+func (f StringField) StartsWith(value string) Predicate {
+	return Predicate(fmt.Sprintf("%s starts with %s", f, quote(value)))
+}
+
+// NumberField is a numeric resource field, e.g. an order's total price.
+type NumberField string
+
+func (f NumberField) Eq(value float64) Predicate {
+	return Predicate(fmt.Sprintf("%s = %s", f, formatNumber(value)))
+}
+
+func (f NumberField) LessThan(value float64) Predicate {
+	return Predicate(fmt.Sprintf("%s < %s", f, formatNumber(value)))
+}
+
+func (f NumberField) GreaterThan(value float64) Predicate {
+	return Predicate(fmt.Sprintf("%s > %s", f, formatNumber(value)))
+}
+
+// BooleanField is a boolean resource field.
+type BooleanField string
+
+func (f BooleanField) Is(value bool) Predicate {
+	return Predicate(fmt.Sprintf("%s = %s", f, strconv.FormatBool(value)))
+}
+
+// LocalizedStringField is a localized string field such as a product's
+// name. Its Lang method yields the StringField for a given locale.
+type LocalizedStringField string
+
+func (f LocalizedStringField) Lang(locale string) StringField {
+	return StringField(fmt.Sprintf("%s(%s)", f, locale))
+}
+
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "\\'") + "'"
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,23 @@
+package predicates_test
+
+import (
+	"testing"
+
+	"github.com/labd/commercetools-go-sdk/commercetools/predicates"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAnd(t *testing.T) {
+	name := predicates.StringField("name")
+	age := predicates.NumberField("age")
+
+	p := predicates.Not(predicates.And(name.Eq("Peter"), age.LessThan(42)))
+
+	assert.Equal(t, "not (name = 'Peter' and age < 42)", p.String())
+}
+
+func TestStartsWith(t *testing.T) {
+	slug := predicates.StringField("slug(en)")
+
+	assert.Equal(t, "slug(en) starts with 'foo'", slug.StartsWith("foo").String())
+}
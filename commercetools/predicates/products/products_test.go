@@ -0,0 +1,22 @@
+package products_test
+
+import (
+	"testing"
+
+	"github.com/labd/commercetools-go-sdk/commercetools/predicates/products"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugStartsWith(t *testing.T) {
+	p := products.Slug("en").StartsWith("foo")
+
+	assert.Equal(t, "slug(en) starts with 'foo'", p.String())
+}
+
+func TestKeyEq(t *testing.T) {
+	assert.Equal(t, "key = 'winter-coat'", products.Key.Eq("winter-coat").String())
+}
+
+func TestMasterVariantSKUEq(t *testing.T) {
+	assert.Equal(t, "masterVariant.sku = 'M-123'", products.MasterVariantSKU.Eq("M-123").String())
+}
@@ -0,0 +1,31 @@
+package commercetools
+
+// LocalizedString maps an ISO 639-1 locale (e.g. "en") to the string value
+// for that locale.
+type LocalizedString map[string]string
+
+// Product represents a commercetools product. Only the fields used
+// elsewhere in the SDK are modeled today.
+//
+// Fields tagged `predicate:"..."` are the schema that
+// internal/cmd/genpredicates reads to generate package predicates/products
+// (see that package's doc comment); add a tag there when a field needs a
+// query predicate.
+//
+//go:generate go run ../internal/cmd/genpredicates -type Product -in product.go -out predicates/products/products.go -pkg products
+type Product struct {
+	ID      string `json:"id"`
+	Version int    `json:"version"`
+
+	Key  string          `json:"key,omitempty" predicate:"string"`
+	Name LocalizedString `json:"name,omitempty" predicate:"localizedString"`
+	Slug LocalizedString `json:"slug,omitempty" predicate:"localizedString"`
+
+	MasterVariant ProductVariant `json:"masterVariant,omitempty"`
+}
+
+// ProductVariant is a single variant of a Product, such as its master
+// variant.
+type ProductVariant struct {
+	SKU string `json:"sku,omitempty" predicate:"string,sku"`
+}
@@ -0,0 +1,18 @@
+package commercetools
+
+import (
+	"fmt"
+	"io"
+)
+
+// UploadProductImage uploads image data as a new image on the product's
+// master variant, streaming body directly to commercetools instead of
+// buffering the whole file in memory first.
+func (c *Client) UploadProductImage(productID string, contentType string, body io.Reader) (*Product, error) {
+	output := &Product{}
+	path := fmt.Sprintf("/products/%s/images", productID)
+	if err := c.PostStream("UploadProductImage", path, nil, contentType, body, output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
@@ -0,0 +1,59 @@
+package commercetools
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// QueryInput holds the common query string parameters accepted by
+// commercetools Query endpoints.
+type QueryInput struct {
+	// Where is a commercetools predicate expression, e.g.
+	// `not (name = 'Peter' and age < 42)`.
+	Where string
+
+	// Sort holds one or more `field direction` expressions, applied in
+	// order.
+	Sort []string
+
+	// Expand is a reference path to expand in the response, e.g.
+	// "taxCategory".
+	Expand string
+
+	Limit  int
+	Offset int
+
+	// WithTotal controls whether the response includes the total result
+	// count. Left nil, commercetools defaults to true.
+	WithTotal *bool
+}
+
+// Values encodes the QueryInput as the url.Values commercetools expects on
+// the query string.
+func (q *QueryInput) Values() url.Values {
+	v := url.Values{}
+	if q == nil {
+		return v
+	}
+
+	if q.Where != "" {
+		v.Set("where", q.Where)
+	}
+	for _, sort := range q.Sort {
+		v.Add("sort", sort)
+	}
+	if q.Expand != "" {
+		v.Set("expand", q.Expand)
+	}
+	if q.Limit != 0 {
+		v.Set("limit", strconv.Itoa(q.Limit))
+	}
+	if q.Offset != 0 {
+		v.Set("offset", strconv.Itoa(q.Offset))
+	}
+	if q.WithTotal != nil {
+		v.Set("withTotal", strconv.FormatBool(*q.WithTotal))
+	}
+
+	return v
+}
@@ -0,0 +1,88 @@
+package commercetools
+
+import (
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how the Client retries failed requests. The zero
+// value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is attempted,
+	// including the first try.
+	MaxAttempts int
+
+	// BaseDelay is the upper bound of the jittered delay before the first
+	// retry; each subsequent retry doubles that bound, up to MaxDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the backoff delay. Zero means uncapped.
+	MaxDelay time.Duration
+
+	// PerCodeMaxAttempts overrides MaxAttempts for specific
+	// ErrorResponse.Errors[i].Code() values, e.g. a larger budget for
+	// "ConcurrentModification" than the default.
+	PerCodeMaxAttempts map[string]int
+}
+
+// DefaultRetryPolicy retries rate-limited, overloaded, and
+// ConcurrentModification responses with jittered exponential backoff, for
+// at most 3 attempts in all (the first try plus 2 retries).
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+	}
+}
+
+// nextDelay reports whether err is retryable under the policy for the
+// given (zero-indexed) attempt, and if so how long to wait first. It
+// honors commercetools' Retry-After header on 429/503 responses and falls
+// back to jittered exponential backoff for those and for
+// ConcurrentModification errors, the standard pattern commercetools uses
+// for optimistic concurrency on resources such as Cart and Order.
+func (p *RetryPolicy) nextDelay(attempt int, err error) (time.Duration, bool) {
+	if p == nil {
+		return 0, false
+	}
+
+	ctErr, ok := err.(ErrorResponse)
+	if !ok {
+		return 0, false
+	}
+
+	maxAttempts := p.MaxAttempts
+	for _, e := range ctErr.Errors {
+		if override, ok := p.PerCodeMaxAttempts[e.Code()]; ok {
+			maxAttempts = override
+		}
+	}
+	if attempt+1 >= maxAttempts {
+		return 0, false
+	}
+
+	switch {
+	case ctErr.StatusCode == http.StatusTooManyRequests || ctErr.StatusCode == http.StatusServiceUnavailable:
+		if ctErr.RetryAfter != nil {
+			return *ctErr.RetryAfter, true
+		}
+		return p.backoff(attempt), true
+	case ctErr.HasErrorCode("ConcurrentModification"):
+		return p.backoff(attempt), true
+	default:
+		return 0, false
+	}
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay * time.Duration(int64(1)<<uint(attempt))
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	// Full jitter: a random delay between 0 and the computed backoff, so
+	// concurrent retries after a contention hot spot don't all land on the
+	// same tick.
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
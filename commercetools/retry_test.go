@@ -0,0 +1,70 @@
+package commercetools_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/labd/commercetools-go-sdk/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryOnConcurrentModification(t *testing.T) {
+	attempts := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"statusCode": 409, "message": "conflict", "errors": [{"code": "ConcurrentModification", "message": "conflict"}]}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}
+
+	client, server := testutil.MockClientWithConfig(t, "", nil, handler, fastRetries)
+	defer server.Close()
+
+	output := OutputData{}
+	err := client.Get("Test", "/", nil, &output)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"statusCode": 409, "message": "conflict", "errors": [{"code": "ConcurrentModification", "message": "conflict"}]}`))
+	}
+
+	client, server := testutil.MockClientWithConfig(t, "", nil, handler, fastRetries)
+	defer server.Close()
+
+	output := OutputData{}
+	err := client.Get("Test", "/", nil, &output)
+
+	assert.NotNil(t, err)
+	ctErr, ok := err.(commercetools.ErrorResponse)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, true, ctErr.HasErrorCode("ConcurrentModification"))
+	assert.Equal(t, 3, attempts)
+}
+
+func TestDefaultRetryPolicyBaseDelay(t *testing.T) {
+	policy := commercetools.DefaultRetryPolicy()
+	assert.Equal(t, 200*time.Millisecond, policy.BaseDelay)
+	assert.Equal(t, 3, policy.MaxAttempts)
+}
+
+// fastRetries keeps the default retry policy's attempt count and error
+// matching but shrinks the delays, so tests that exercise real retries
+// don't sleep through production backoff.
+func fastRetries(cfg *commercetools.Config) {
+	policy := commercetools.DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+	cfg.RetryPolicy = policy
+}
@@ -0,0 +1,90 @@
+package commercetools
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetStream issues a GET request against path and returns the raw response
+// body without decoding it as JSON, for endpoints that return payloads too
+// large (or not shaped) to buffer in memory, such as an export result. Like
+// Get, it is instrumented with a span and the request-duration metric;
+// operation names the span, and like Get's should be a fixed,
+// resource-shaped name rather than anything derived from path.
+//
+// The caller owns the returned ReadCloser and must close it. On a non-2xx
+// response the body is read, decoded as an ErrorResponse, and closed
+// before returning.
+func (c *Client) GetStream(operation, path string, params url.Values) (io.ReadCloser, error) {
+	ctx, span := c.tracer.Start(c.Context, operation, trace.WithAttributes(
+		attribute.String("http.method", http.MethodGet),
+		attribute.String("commercetools.projectKey", c.Config.ProjectKey),
+	))
+	defer span.End()
+
+	resp, err := c.sendRequest(ctx, span, http.MethodGet, path, params, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	ok := false
+	defer func() {
+		if !ok {
+			resp.Body.Close()
+		}
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, readErr := io.ReadAll(resp.Body)
+		if readErr != nil {
+			span.RecordError(readErr)
+			span.SetStatus(codes.Error, readErr.Error())
+			return nil, readErr
+		}
+
+		respErr := c.handleErrorResponse(resp, data)
+		span.RecordError(respErr)
+		span.SetStatus(codes.Error, respErr.Error())
+		c.errorCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("commercetools.errorClass", errorClass(respErr))))
+		return nil, respErr
+	}
+
+	ok = true
+	return resp.Body, nil
+}
+
+// PostStream issues a POST request whose body is streamed directly from
+// body instead of first being buffered and JSON-encoded, for endpoints
+// such as image uploads or large imports where the caller already has an
+// io.Reader (a file, a multipart section, ...). The JSON response body, if
+// any, is decoded into output as with Post. Like Post, it is instrumented
+// with a span, named by operation, and the request-duration metric; unlike
+// Post it is not retried, since a streamed body generally can't be
+// replayed.
+func (c *Client) PostStream(operation, path string, params url.Values, contentType string, body io.Reader, output interface{}) error {
+	ctx, span := c.tracer.Start(c.Context, operation, trace.WithAttributes(
+		attribute.String("http.method", http.MethodPost),
+		attribute.String("commercetools.projectKey", c.Config.ProjectKey),
+	))
+	defer span.End()
+
+	resp, err := c.sendRequest(ctx, span, http.MethodPost, path, params, body, contentType)
+	if err != nil {
+		return err
+	}
+
+	if err := c.handleResponse(resp, output); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.errorCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("commercetools.errorClass", errorClass(err))))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package commercetools_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/labd/commercetools-go-sdk/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetStreamReturnsBody(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("exported data"))
+	}
+	client, server := testutil.MockClient(t, "", nil, handler)
+	defer server.Close()
+
+	body, err := client.GetStream("ExportTest", "/export", nil)
+	assert.Nil(t, err)
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	assert.Nil(t, err)
+	assert.Equal(t, "exported data", string(data))
+}
+
+func TestGetStreamReturnsErrorResponse(t *testing.T) {
+	client, server := testutil.MockClientWithConfig(
+		t, "", nil, errorHandler(http.StatusNotFound, "", "application/json"),
+		disableRetries)
+	defer server.Close()
+
+	body, err := client.GetStream("ExportTest", "/export", nil)
+	assert.Nil(t, body)
+
+	ctErr, ok := err.(commercetools.ErrorResponse)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 404, ctErr.StatusCode)
+}
+
+func TestPostStreamDecodesOutput(t *testing.T) {
+	var gotContentType, gotBody string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		gotBody = string(data)
+		gotContentType = r.Header.Get("Content-Type")
+		w.Write([]byte(`{"id": "product-1", "version": 1}`))
+	}
+	client, server := testutil.MockClient(t, "", nil, handler)
+	defer server.Close()
+
+	output := &commercetools.Product{}
+	err := client.PostStream("UploadTest", "/products/product-1/images", nil, "image/png", strings.NewReader("binary-image-data"), output)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "product-1", output.ID)
+	assert.Equal(t, 1, output.Version)
+	assert.Equal(t, "image/png", gotContentType)
+	assert.Equal(t, "binary-image-data", gotBody)
+}
+
+func TestPostStreamReturnsErrorResponse(t *testing.T) {
+	body := `{"statusCode": 400, "message": "bad image", "errors": []}`
+	client, server := testutil.MockClientWithConfig(
+		t, "", nil, errorHandler(http.StatusBadRequest, body, "application/json"),
+		disableRetries)
+	defer server.Close()
+
+	output := &commercetools.Product{}
+	err := client.PostStream("UploadTest", "/products/product-1/images", nil, "image/png", strings.NewReader("data"), output)
+
+	ctErr, ok := err.(commercetools.ErrorResponse)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "bad image", ctErr.Message)
+}
+
+func TestUploadProductImage(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "product-1", "version": 2}`))
+	}
+	client, server := testutil.MockClient(t, "", nil, handler)
+	defer server.Close()
+
+	product, err := client.UploadProductImage("product-1", "image/png", strings.NewReader("data"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "product-1", product.ID)
+	assert.Equal(t, 2, product.Version)
+}
+
+func TestUploadProductImageSpanNameIsNotPerProductID(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "product-1", "version": 2}`))
+	}
+	client, server := testutil.MockClientWithConfig(t, "", nil, handler, func(cfg *commercetools.Config) {
+		cfg.TracerProvider = tracerProvider
+	})
+	defer server.Close()
+
+	_, err := client.UploadProductImage("product-1", "image/png", strings.NewReader("data"))
+	assert.Nil(t, err)
+	_, err = client.UploadProductImage("product-2", "image/png", strings.NewReader("data"))
+	assert.Nil(t, err)
+
+	spans := recorder.Ended()
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+	assert.Equal(t, "UploadProductImage", spans[0].Name())
+	assert.Equal(t, "UploadProductImage", spans[1].Name())
+}
+
+func TestImportStream(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}
+	client, server := testutil.MockClient(t, "", nil, handler)
+	defer server.Close()
+
+	output := OutputData{}
+	err := client.ImportStream("/import/products", "application/json", strings.NewReader(`{"name": "feed"}`), &output)
+
+	assert.Nil(t, err)
+}
+
+func TestExportStream(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("exported"))
+	}
+	client, server := testutil.MockClient(t, "", nil, handler)
+	defer server.Close()
+
+	body, err := client.ExportStream("/export/products", nil)
+	assert.Nil(t, err)
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	assert.Nil(t, err)
+	assert.Equal(t, "exported", string(data))
+}
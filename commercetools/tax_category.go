@@ -0,0 +1,29 @@
+package commercetools
+
+// TaxCategory represents a set of tax rates that can be applied to line
+// items and shipping methods.
+type TaxCategory struct {
+	ID      string `json:"id"`
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+	Key     string `json:"key,omitempty"`
+}
+
+// TaxCategoryPagedQueryResponse is the paged result of a TaxCategoryQuery
+// call.
+type TaxCategoryPagedQueryResponse struct {
+	Limit   int           `json:"limit"`
+	Offset  int           `json:"offset"`
+	Count   int           `json:"count"`
+	Total   int           `json:"total"`
+	Results []TaxCategory `json:"results"`
+}
+
+// TaxCategoryQuery queries the project's tax categories.
+func (c *Client) TaxCategoryQuery(input *QueryInput) (*TaxCategoryPagedQueryResponse, error) {
+	output := &TaxCategoryPagedQueryResponse{}
+	if err := c.Query("TaxCategory", "/tax-categories", input, output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
@@ -0,0 +1,70 @@
+package commercetools
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// refreshMargin is how far ahead of a token's expiry the scheduler
+// refreshes it, so in-flight requests never race a 401 caused by the
+// token expiring mid-call.
+const refreshMargin = 30 * time.Second
+
+// TokenSource supplies the bearer token used to authenticate requests.
+// Implementations are responsible for their own caching; Token is called
+// again every time the scheduler thinks a refresh is due.
+type TokenSource interface {
+	Token(ctx context.Context) (Token, error)
+}
+
+// Token is an OAuth access token and its expiry.
+type Token struct {
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+// tokenScheduler proactively refreshes a Client's OAuth token ahead of
+// expiry, rather than waiting for a request to fail with 401.
+type tokenScheduler struct {
+	source    TokenSource
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+func newTokenScheduler(source TokenSource) *tokenScheduler {
+	return &tokenScheduler{
+		source: source,
+		stop:   make(chan struct{}),
+	}
+}
+
+func (s *tokenScheduler) run(ctx context.Context) {
+	for {
+		wait := refreshMargin
+		if token, err := s.source.Token(ctx); err == nil {
+			if untilExpiry := time.Until(token.ExpiresAt) - refreshMargin; untilExpiry > 0 {
+				wait = untilExpiry
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-s.stop:
+			timer.Stop()
+			return
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// close stops run, if it is still running. It is safe to call more than
+// once.
+func (s *tokenScheduler) close() {
+	s.closeOnce.Do(func() {
+		close(s.stop)
+	})
+}
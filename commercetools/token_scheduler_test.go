@@ -0,0 +1,128 @@
+package commercetools
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingTokenSource struct {
+	calls   int32
+	expires time.Time
+}
+
+func (s *countingTokenSource) Token(ctx context.Context) (Token, error) {
+	atomic.AddInt32(&s.calls, 1)
+	return Token{AccessToken: "token", ExpiresAt: s.expires}, nil
+}
+
+func TestTokenSchedulerRefreshesAheadOfExpiry(t *testing.T) {
+	source := &countingTokenSource{expires: time.Now().Add(refreshMargin + 20*time.Millisecond)}
+	scheduler := newTokenScheduler(source)
+
+	done := make(chan struct{})
+	go func() {
+		scheduler.run(context.Background())
+		close(done)
+	}()
+
+	// The first call happens immediately on entry to run(); the second
+	// should happen roughly 20ms later, once the scheduler wakes up
+	// refreshMargin ahead of the token's expiry.
+	time.Sleep(60 * time.Millisecond)
+	scheduler.close()
+	<-done
+
+	assert.GreaterOrEqual(t, int(atomic.LoadInt32(&source.calls)), 2)
+}
+
+func TestTokenSchedulerCloseStopsRun(t *testing.T) {
+	source := &countingTokenSource{expires: time.Now().Add(time.Hour)}
+	scheduler := newTokenScheduler(source)
+
+	done := make(chan struct{})
+	go func() {
+		scheduler.run(context.Background())
+		close(done)
+	}()
+
+	scheduler.close()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("run did not return after close")
+	}
+}
+
+func TestNewStartsAndCloseStopsTokenScheduler(t *testing.T) {
+	source := &countingTokenSource{expires: time.Now().Add(time.Hour)}
+	client := New(&Config{TokenSource: source})
+	assert.NotNil(t, client.scheduler)
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&source.calls) >= 1
+	}, time.Second, time.Millisecond, "expected New to start a scheduler that calls Token at least once")
+
+	client.Close()
+
+	callsAfterClose := atomic.LoadInt32(&source.calls)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, callsAfterClose, atomic.LoadInt32(&source.calls), "expected no further Token calls after Close")
+}
+
+func TestClientCloseWithoutTokenSourceIsNoop(t *testing.T) {
+	client := New(&Config{})
+	client.Close()
+}
+
+func TestClientCloseIsIdempotent(t *testing.T) {
+	source := &countingTokenSource{expires: time.Now().Add(time.Hour)}
+	client := New(&Config{TokenSource: source})
+
+	client.Close()
+	client.Close()
+}
+
+func TestWithContextCloneCannotCloseSharedScheduler(t *testing.T) {
+	source := &countingTokenSource{expires: time.Now().Add(refreshMargin + 20*time.Millisecond)}
+	client := New(&Config{TokenSource: source})
+	clone := client.WithContext(context.Background())
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&source.calls) >= 1
+	}, time.Second, time.Millisecond, "expected New to start a scheduler that calls Token at least once")
+
+	clone.Close()
+
+	// The clone doesn't own the scheduler, so its Close must be a no-op:
+	// the scheduler should still wake up ~20ms later and call Token again.
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&source.calls) >= 2
+	}, time.Second, time.Millisecond, "expected the clone's Close to leave the shared scheduler running")
+
+	client.Close()
+}
+
+func TestTokenSchedulerStopsOnContextCancel(t *testing.T) {
+	source := &countingTokenSource{expires: time.Now().Add(time.Hour)}
+	scheduler := newTokenScheduler(source)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		scheduler.run(ctx)
+		close(done)
+	}()
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("run did not return after context cancellation")
+	}
+}
@@ -0,0 +1,231 @@
+// Command genpredicates generates a package predicates/<resource> file of
+// typed query predicate fields (see package predicates) from the
+// `predicate:"..."` struct tags on a resource struct, so the generated
+// fields stay in sync with the struct that already defines the resource's
+// shape instead of being maintained by hand in two places.
+//
+// A field's tag is `predicate:"<kind>[,<path>]"`, where kind is one of
+// string, number, boolean, localizedString, and path is the dotted
+// predicate path to use (e.g. "masterVariant.sku"); path defaults to the
+// field name with its first letter lowercased. A field with no tag whose
+// type is itself a struct declared in the same file is descended into,
+// with its own tagged fields' paths and generated names prefixed by the
+// parent field's name, so e.g. a MasterVariant field of type
+// ProductVariant with a tagged SKU field yields MasterVariantSKU.
+//
+// Typical usage, from the package being generated:
+//
+//	//go:generate go run ../../../internal/cmd/genpredicates -type Product -in ../../../commercetools/product.go -out products.go -pkg products
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	typeName := flag.String("type", "", "name of the resource struct to generate predicate fields for")
+	inPath := flag.String("in", "", "path to the Go source file declaring -type")
+	outPath := flag.String("out", "", "path to write the generated file to")
+	pkgName := flag.String("pkg", "", "package name for the generated file")
+	flag.Parse()
+
+	if *typeName == "" || *inPath == "" || *outPath == "" || *pkgName == "" {
+		log.Fatal("genpredicates: -type, -in, -out and -pkg are all required")
+	}
+
+	fields, sourceType, err := collectFields(*inPath, *typeName)
+	if err != nil {
+		log.Fatalf("genpredicates: %s", err)
+	}
+
+	src, err := render(*pkgName, sourceType, fields)
+	if err != nil {
+		log.Fatalf("genpredicates: %s", err)
+	}
+
+	if err := os.WriteFile(*outPath, src, 0o644); err != nil {
+		log.Fatalf("genpredicates: %s", err)
+	}
+}
+
+// field is a single generated predicate field.
+type field struct {
+	GoName string // identifier to emit, e.g. "MasterVariantSKU"
+	Kind   string // string, number, boolean, localizedString
+	Path   string // predicate path, e.g. "masterVariant.sku"
+}
+
+// collectFields parses the Go source file at path and walks the fields of
+// the struct named typeName (recursing into same-file struct-typed fields
+// that have no predicate tag of their own), returning the predicate fields
+// to generate and the "pkg.Type" the fields were sourced from.
+func collectFields(path, typeName string) ([]field, string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, "", fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	structs := map[string]*ast.StructType{}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+				structs[typeSpec.Name.Name] = structType
+			}
+		}
+	}
+
+	root, ok := structs[typeName]
+	if !ok {
+		return nil, "", fmt.Errorf("no struct type %q found in %s", typeName, path)
+	}
+
+	var fields []field
+	walkFields(root, structs, "", "", &fields)
+
+	return fields, file.Name.Name + "." + typeName, nil
+}
+
+func walkFields(structType *ast.StructType, structs map[string]*ast.StructType, namePrefix, pathPrefix string, out *[]field) {
+	for _, f := range structType.Fields.List {
+		if len(f.Names) != 1 {
+			continue
+		}
+		name := f.Names[0].Name
+
+		tag := fieldTag(f)
+		if tag != "" {
+			kind, path, ok := parseTag(tag)
+			if !ok {
+				continue
+			}
+			if path == "" {
+				path = lowerFirst(name)
+			}
+			*out = append(*out, field{
+				GoName: namePrefix + name,
+				Kind:   kind,
+				Path:   pathPrefix + path,
+			})
+			continue
+		}
+
+		ident, ok := f.Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		nested, ok := structs[ident.Name]
+		if !ok {
+			continue
+		}
+		walkFields(nested, structs, namePrefix+name, pathPrefix+lowerFirst(name)+".", out)
+	}
+}
+
+func fieldTag(f *ast.Field) string {
+	if f.Tag == nil {
+		return ""
+	}
+	raw := strings.Trim(f.Tag.Value, "`")
+	const key = "predicate:\""
+	start := strings.Index(raw, key)
+	if start == -1 {
+		return ""
+	}
+	start += len(key)
+	end := strings.Index(raw[start:], "\"")
+	if end == -1 {
+		return ""
+	}
+	return raw[start : start+end]
+}
+
+func parseTag(tag string) (kind, path string, ok bool) {
+	parts := strings.SplitN(tag, ",", 2)
+	kind = parts[0]
+	switch kind {
+	case "string", "number", "boolean", "localizedString":
+	default:
+		return "", "", false
+	}
+	if len(parts) == 2 {
+		path = parts[1]
+	}
+	return kind, path, true
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+const fileTemplate = `// Package %[1]s provides typed predicate fields for the %[2]s
+// resource, for use with commercetools.QueryInput.Where.
+//
+// Code generated by genpredicates from %[3]s. DO NOT EDIT.
+package %[1]s
+
+import (
+%[4]s
+	"github.com/labd/commercetools-go-sdk/commercetools/predicates"
+)
+
+%[5]s`
+
+func render(pkgName, sourceType string, fields []field) ([]byte, error) {
+	resourceName := sourceType[strings.LastIndex(sourceType, ".")+1:]
+
+	needsFmt := false
+	var body strings.Builder
+	for i, f := range fields {
+		if i > 0 {
+			body.WriteString("\n\n")
+		}
+		switch f.Kind {
+		case "string":
+			fmt.Fprintf(&body, "// %s is the %s's %s field.\nvar %s = predicates.StringField(%q)",
+				f.GoName, resourceName, f.Path, f.GoName, f.Path)
+		case "number":
+			fmt.Fprintf(&body, "// %s is the %s's %s field.\nvar %s = predicates.NumberField(%q)",
+				f.GoName, resourceName, f.Path, f.GoName, f.Path)
+		case "boolean":
+			fmt.Fprintf(&body, "// %s is the %s's %s field.\nvar %s = predicates.BooleanField(%q)",
+				f.GoName, resourceName, f.Path, f.GoName, f.Path)
+		case "localizedString":
+			needsFmt = true
+			fmt.Fprintf(&body, "// %s returns the %s's %s field for the given locale, e.g.\n// %s(\"en\").\nfunc %s(locale string) predicates.StringField {\n\treturn predicates.StringField(fmt.Sprintf(\"%s(%%s)\", locale))\n}",
+				f.GoName, resourceName, f.Path, f.GoName, f.GoName, f.Path)
+		}
+	}
+
+	imports := ""
+	if needsFmt {
+		imports = "\t\"fmt\"\n\n"
+	}
+
+	src := fmt.Sprintf(fileTemplate, pkgName, resourceName, sourceType, imports, body.String())
+
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w", err)
+	}
+	return formatted, nil
+}
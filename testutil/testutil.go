@@ -0,0 +1,69 @@
+// Package testutil provides a mock commercetools API server for use in the
+// commercetools package's own tests.
+package testutil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+// RequestData captures the request that hit the mock server so tests can
+// assert on it.
+type RequestData struct {
+	Method string
+	URL    *url.URL
+}
+
+// MockClient starts an httptest.Server and returns a Client configured to
+// talk to it.
+//
+// When handler is nil, every request is answered with a 200 response whose
+// body is responseBody. When handler is set it takes over the response
+// entirely (responseBody is ignored). If output is non-nil, the incoming
+// request's method and URL are recorded into it before the response is
+// written.
+func MockClient(t *testing.T, responseBody string, output *RequestData, handler http.HandlerFunc) (*commercetools.Client, *httptest.Server) {
+	t.Helper()
+	return MockClientWithConfig(t, responseBody, output, handler, nil)
+}
+
+// MockClientWithConfig behaves like MockClient, but calls configure (if
+// non-nil) on the Config before the Client is constructed, so tests can
+// plug in e.g. a TracerProvider/MeterProvider to assert on, or a
+// RetryPolicy that disables retries so error-path tests don't sleep
+// through real backoff delays.
+func MockClientWithConfig(t *testing.T, responseBody string, output *RequestData, handler http.HandlerFunc, configure func(*commercetools.Config)) (*commercetools.Client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if output != nil {
+			output.Method = r.Method
+			output.URL = r.URL
+		}
+
+		if handler != nil {
+			handler(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(responseBody))
+	}))
+
+	cfg := &commercetools.Config{
+		URL:        server.URL,
+		ProjectKey: "test-project",
+		HTTPClient: server.Client(),
+	}
+	if configure != nil {
+		configure(cfg)
+	}
+
+	client := commercetools.New(cfg)
+
+	return client, server
+}